@@ -0,0 +1,114 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/smalldevshima/go-monkey/object"
+)
+
+// Built-in function error format strings
+const (
+	ERR_BUILTIN_WRONG_ARG_COUNT ErrorFormat = "wrong number of arguments. got=%d, want=%d"
+	ERR_BUILTIN_ARG_TYPE        ErrorFormat = "argument to %q not supported, got %s"
+)
+
+// Builtins holds every function built into the language, consulted by
+// evalIdentifier once a lookup in the current Environment fails.
+var Builtins = map[string]*object.Builtin{
+	"len":   {Fn: builtinLen},
+	"first": {Fn: builtinFirst},
+	"last":  {Fn: builtinLast},
+	"rest":  {Fn: builtinRest},
+	"push":  {Fn: builtinPush},
+	"puts":  {Fn: builtinPuts},
+}
+
+func builtinLen(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError(ERR_BUILTIN_WRONG_ARG_COUNT, len(args), 1)
+	}
+
+	switch arg := args[0].(type) {
+	case *object.String:
+		return &object.Integer{Value: int64(len(arg.Value))}
+	case *object.Array:
+		return &object.Integer{Value: int64(len(arg.Elements))}
+	default:
+		return newError(ERR_BUILTIN_ARG_TYPE, "len", args[0].Type())
+	}
+}
+
+func builtinFirst(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError(ERR_BUILTIN_WRONG_ARG_COUNT, len(args), 1)
+	}
+
+	arr, ok := args[0].(*object.Array)
+	if !ok {
+		return newError(ERR_BUILTIN_ARG_TYPE, "first", args[0].Type())
+	}
+
+	if len(arr.Elements) > 0 {
+		return arr.Elements[0]
+	}
+	return NULL
+}
+
+func builtinLast(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError(ERR_BUILTIN_WRONG_ARG_COUNT, len(args), 1)
+	}
+
+	arr, ok := args[0].(*object.Array)
+	if !ok {
+		return newError(ERR_BUILTIN_ARG_TYPE, "last", args[0].Type())
+	}
+
+	if length := len(arr.Elements); length > 0 {
+		return arr.Elements[length-1]
+	}
+	return NULL
+}
+
+func builtinRest(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError(ERR_BUILTIN_WRONG_ARG_COUNT, len(args), 1)
+	}
+
+	arr, ok := args[0].(*object.Array)
+	if !ok {
+		return newError(ERR_BUILTIN_ARG_TYPE, "rest", args[0].Type())
+	}
+
+	if length := len(arr.Elements); length > 0 {
+		newElements := make([]object.Object, length-1)
+		copy(newElements, arr.Elements[1:length])
+		return &object.Array{Elements: newElements}
+	}
+	return NULL
+}
+
+func builtinPush(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError(ERR_BUILTIN_WRONG_ARG_COUNT, len(args), 2)
+	}
+
+	arr, ok := args[0].(*object.Array)
+	if !ok {
+		return newError(ERR_BUILTIN_ARG_TYPE, "push", args[0].Type())
+	}
+
+	length := len(arr.Elements)
+	newElements := make([]object.Object, length+1)
+	copy(newElements, arr.Elements)
+	newElements[length] = args[1]
+
+	return &object.Array{Elements: newElements}
+}
+
+func builtinPuts(args ...object.Object) object.Object {
+	for _, arg := range args {
+		fmt.Println(arg.Inspect())
+	}
+	return NULL
+}
@@ -1,259 +1,446 @@
-package evaluator
-
-import (
-	"fmt"
-
-	"github.com/smalldevshima/go-monkey/ast"
-	"github.com/smalldevshima/go-monkey/object"
-)
-
-// Constants / Variables
-
-// Error format strings
-const (
-	ERR_PREFIX_UNKNOWN     ErrorFormat = "unknown operator: %s%s"
-	ERR_INFIX_UNKNOWN      ErrorFormat = "unknown operator: %s %s %s"
-	ERR_INFIX_MISMATCH     ErrorFormat = "type mismatch: %s %s %s"
-	ERR_IDENTIFIER_UNKNOWN ErrorFormat = "unknown identifier: %s"
-)
-
-var (
-	NULL = &object.Null{}
-
-	TRUE  = &object.Boolean{Value: true}
-	FALSE = &object.Boolean{Value: false}
-
-	// FALSY_VALUES is a list of all object values considered falsy in Monkey
-	FALSY_VALUES = []object.Object{NULL, FALSE}
-)
-
-// Functions
-
-// isTruthy defines which values are truthy in the Monkey language
-func isTruthy(obj object.Object) bool {
-	for _, falsyVal := range FALSY_VALUES {
-		if falsyVal == obj {
-			return false
-		}
-	}
-
-	return true
-}
-
-func newError(format ErrorFormat, a ...interface{}) *object.Error {
-	return &object.Error{Message: fmt.Sprintf(string(format), a...)}
-}
-
-func isError(obj object.Object) bool {
-	if obj != nil {
-		return obj.Type() == object.O_ERROR
-	}
-	return false
-}
-
-func Eval(node ast.Node, env *object.Environment) object.Object {
-	switch node := node.(type) {
-	// * Statements:
-	case *ast.Program:
-		return evalProgram(node.Statements, env)
-	case *ast.BlockStatement:
-		return evalBlockStatement(node.Statements, env)
-	case *ast.ExpressionStatement:
-		return Eval(node.Expression, env)
-	case *ast.ReturnStatement:
-		val := Eval(node.ReturnValue, env)
-		if isError(val) {
-			return val
-		}
-		return &object.ReturnValue{Value: val}
-	case *ast.LetStatement:
-		val := Eval(node.Value, env)
-		if isError(val) {
-			return val
-		}
-		env.Set(node.Name.Value, val)
-
-	// * Literal expressions:
-	case *ast.BooleanLiteral:
-		return nativeBooleanToObject(node.Value)
-	case *ast.IntegerLiteral:
-		return &object.Integer{Value: node.Value}
-	case *ast.FunctionLiteral:
-		params := node.Parameters
-		body := node.Body
-		return &object.Function{Parameters: params, Body: body, Env: env}
-
-	// * Operator expressions:
-	case *ast.PrefixExpression:
-		operand := Eval(node.Right, env)
-		if isError(operand) {
-			return operand
-		}
-		return evalPrefixExpression(node.Operator, operand)
-	case *ast.InfixExpression:
-		left := Eval(node.Left, env)
-		if isError(left) {
-			return left
-		}
-		right := Eval(node.Right, env)
-		if isError(right) {
-			return right
-		}
-		return evalInfixExpression(node.Operator, left, right)
-
-	// * Control flow expressions:
-	case *ast.IfExpression:
-		return evalIfExpression(node, env)
-
-	// * Identifiers, function calls:
-	case *ast.Identifier:
-		return evalIdentifier(node, env)
-	}
-
-	return nil
-}
-
-func nativeBooleanToObject(input bool) *object.Boolean {
-	if input {
-		return TRUE
-	}
-	return FALSE
-}
-
-func evalProgram(statements []ast.Statement, env *object.Environment) object.Object {
-	var result object.Object
-
-	for _, stmt := range statements {
-		result = Eval(stmt, env)
-
-		// * return early, if result is an object.ReturnValue or an object.Error
-		switch result := result.(type) {
-		case *object.ReturnValue:
-			return result.Value
-		case *object.Error:
-			return result
-		}
-	}
-
-	return result
-}
-
-func evalBlockStatement(statements []ast.Statement, env *object.Environment) object.Object {
-	var result object.Object
-
-	for _, stmt := range statements {
-		result = Eval(stmt, env)
-
-		if result != nil {
-			// * return early, if result type is object.O_RETURN_VALUE or object.O_ERRIR
-			if result.Type() == object.O_RETURN_VALUE || isError(result) {
-				return result
-			}
-		}
-	}
-
-	return result
-}
-
-func evalPrefixExpression(operator string, operand object.Object) object.Object {
-	switch operator {
-	case "!":
-		return evalBangOperatorExpression(operand)
-	case "-":
-		return evalDashOperatorExpression(operand)
-	}
-	return newError(ERR_PREFIX_UNKNOWN, operator, operand.Type())
-}
-
-// evalBangOperatorExpression returns the opposite object of the isTruthy(operand) result
-func evalBangOperatorExpression(operand object.Object) object.Object {
-	if isTruthy(operand) {
-		return FALSE
-	}
-	return TRUE
-}
-
-func evalDashOperatorExpression(operand object.Object) object.Object {
-	if operand.Type() != object.O_INTEGER {
-		return newError(ERR_PREFIX_UNKNOWN, "-", operand.Type())
-	}
-
-	value := operand.(*object.Integer).Value
-	return &object.Integer{Value: -value}
-}
-
-func evalInfixExpression(operator string, left, right object.Object) object.Object {
-	switch {
-	// * need to switch on both the type of left and right
-	case left.Type() != right.Type():
-		return newError(ERR_INFIX_MISMATCH, left.Type(), operator, right.Type())
-	case left.Type() == object.O_INTEGER && right.Type() == object.O_INTEGER:
-		return evalIntegerInfixExpression(operator, left, right)
-
-	// * special cases for infix operators '==' and '!='
-	// * directly compare pointers, since booleans and null use global objects
-	// * all other types are filtered out by preceding cases
-	case operator == "==":
-		return nativeBooleanToObject(left == right)
-	case operator == "!=":
-		return nativeBooleanToObject(left != right)
-	}
-
-	return newError(ERR_INFIX_UNKNOWN, left.Type(), operator, right.Type())
-}
-
-func evalIntegerInfixExpression(operator string, left, right object.Object) object.Object {
-	leftInt := left.(*object.Integer).Value
-	rightInt := right.(*object.Integer).Value
-	var newInt int64
-	switch operator {
-	case "+":
-		newInt = leftInt + rightInt
-	case "-":
-		newInt = leftInt - rightInt
-	case "*":
-		newInt = leftInt * rightInt
-	case "/":
-		newInt = leftInt / rightInt
-	case "==":
-		return nativeBooleanToObject(leftInt == rightInt)
-	case "!=":
-		return nativeBooleanToObject(leftInt != rightInt)
-	case "<":
-		return nativeBooleanToObject(leftInt < rightInt)
-	case ">":
-		return nativeBooleanToObject(leftInt > rightInt)
-	default:
-		return newError(ERR_INFIX_UNKNOWN, left.Type(), operator, right.Type())
-	}
-
-	return &object.Integer{Value: newInt}
-}
-
-func evalIfExpression(ie *ast.IfExpression, env *object.Environment) object.Object {
-	condition := Eval(ie.Condition, env)
-	if isError(condition) {
-		return condition
-	}
-
-	if isTruthy(condition) {
-		return Eval(ie.Then, env)
-	} else if ie.Otherwise != nil {
-		return Eval(ie.Otherwise, env)
-	}
-
-	return NULL
-}
-
-func evalIdentifier(node *ast.Identifier, env *object.Environment) object.Object {
-	val, ok := env.Get(node.Value)
-	if !ok {
-		return newError(ERR_IDENTIFIER_UNKNOWN, node.Value)
-	}
-
-	return val
-}
-
-/// Types
-
-type ErrorFormat string
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/smalldevshima/go-monkey/ast"
+	"github.com/smalldevshima/go-monkey/object"
+)
+
+// Constants / Variables
+
+// Error format strings
+const (
+	ERR_PREFIX_UNKNOWN     ErrorFormat = "unknown operator: %s%s"
+	ERR_INFIX_UNKNOWN      ErrorFormat = "unknown operator: %s %s %s"
+	ERR_INFIX_MISMATCH     ErrorFormat = "type mismatch: %s %s %s"
+	ERR_IDENTIFIER_UNKNOWN ErrorFormat = "unknown identifier: %s"
+	ERR_NOT_A_FUNCTION     ErrorFormat = "not a function: %s"
+	ERR_INDEX_UNSUPPORTED  ErrorFormat = "index operator not supported: %s"
+	ERR_UNUSABLE_HASH_KEY  ErrorFormat = "unusable as hash key: %s"
+	ERR_QUOTE_ARG_COUNT    ErrorFormat = "wrong number of arguments to quote: got=%d, want=1"
+)
+
+var (
+	NULL = &object.Null{}
+
+	TRUE  = &object.Boolean{Value: true}
+	FALSE = &object.Boolean{Value: false}
+
+	// FALSY_VALUES is a list of all object values considered falsy in Monkey
+	FALSY_VALUES = []object.Object{NULL, FALSE}
+)
+
+// Functions
+
+// isTruthy defines which values are truthy in the Monkey language
+func isTruthy(obj object.Object) bool {
+	for _, falsyVal := range FALSY_VALUES {
+		if falsyVal == obj {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newError(format ErrorFormat, a ...interface{}) *object.Error {
+	return &object.Error{Message: fmt.Sprintf(string(format), a...)}
+}
+
+func isError(obj object.Object) bool {
+	if obj != nil {
+		return obj.Type() == object.O_ERROR
+	}
+	return false
+}
+
+func Eval(node ast.Node, env *object.Environment) object.Object {
+	switch node := node.(type) {
+	// * Statements:
+	case *ast.Program:
+		return evalProgram(node.Statements, env)
+	case *ast.BlockStatement:
+		return evalBlockStatement(node.Statements, env)
+	case *ast.ExpressionStatement:
+		return Eval(node.Expression, env)
+	case *ast.ReturnStatement:
+		val := Eval(node.ReturnValue, env)
+		if isError(val) {
+			return val
+		}
+		return &object.ReturnValue{Value: val}
+	case *ast.LetStatement:
+		val := Eval(node.Value, env)
+		if isError(val) {
+			return val
+		}
+		env.Set(node.Name.Value, val)
+
+	// * Literal expressions:
+	case *ast.BooleanLiteral:
+		return nativeBooleanToObject(node.Value)
+	case *ast.IntegerLiteral:
+		return &object.Integer{Value: node.Value}
+	case *ast.StringLiteral:
+		return &object.String{Value: node.Value}
+	case *ast.ArrayLiteral:
+		elements := evalExpressions(node.Elements, env)
+		if len(elements) == 1 && isError(elements[0]) {
+			return elements[0]
+		}
+		return &object.Array{Elements: elements}
+	case *ast.HashLiteral:
+		return evalHashLiteral(node, env)
+	case *ast.FunctionLiteral:
+		params := node.Parameters
+		body := node.Body
+		return &object.Function{Parameters: params, Body: body, Env: env}
+
+	// * Operator expressions:
+	case *ast.PrefixExpression:
+		operand := Eval(node.Right, env)
+		if isError(operand) {
+			return operand
+		}
+		return evalPrefixExpression(node.Operator, operand)
+	case *ast.InfixExpression:
+		left := Eval(node.Left, env)
+		if isError(left) {
+			return left
+		}
+		right := Eval(node.Right, env)
+		if isError(right) {
+			return right
+		}
+		return evalInfixExpression(node.Operator, left, right)
+
+	// * Control flow expressions:
+	case *ast.IfExpression:
+		return evalIfExpression(node, env)
+	case *ast.IndexExpression:
+		left := Eval(node.Left, env)
+		if isError(left) {
+			return left
+		}
+		index := Eval(node.Index, env)
+		if isError(index) {
+			return index
+		}
+		return evalIndexExpression(left, index)
+
+	// * Identifiers, function calls:
+	case *ast.Identifier:
+		return evalIdentifier(node, env)
+	case *ast.CallExpression:
+		if ident, ok := node.Function.(*ast.Identifier); ok && ident.Value == "quote" {
+			if len(node.Arguments) != 1 {
+				return newError(ERR_QUOTE_ARG_COUNT, len(node.Arguments))
+			}
+			return quote(node.Arguments[0], env)
+		}
+
+		function := Eval(node.Function, env)
+		if isError(function) {
+			return function
+		}
+		args := evalExpressions(node.Arguments, env)
+		if len(args) == 1 && isError(args[0]) {
+			return args[0]
+		}
+		return applyFunction(function, args)
+	}
+
+	return nil
+}
+
+// evalExpressions evaluates each expression in order, returning early with a
+// single-element slice containing the error if any expression fails.
+func evalExpressions(exps []ast.Expression, env *object.Environment) []object.Object {
+	var result []object.Object
+
+	for _, exp := range exps {
+		evaluated := Eval(exp, env)
+		if isError(evaluated) {
+			return []object.Object{evaluated}
+		}
+		result = append(result, evaluated)
+	}
+
+	return result
+}
+
+// applyFunction calls fn, a *object.Function or *object.Builtin, with args.
+func applyFunction(fn object.Object, args []object.Object) object.Object {
+	switch fn := fn.(type) {
+	case *object.Function:
+		extendedEnv := extendFunctionEnv(fn, args)
+		evaluated := Eval(fn.Body, extendedEnv)
+		return unwrapReturnValue(evaluated)
+	case *object.Builtin:
+		return fn.Fn(args...)
+	default:
+		return newError(ERR_NOT_A_FUNCTION, fn.Type())
+	}
+}
+
+func extendFunctionEnv(fn *object.Function, args []object.Object) *object.Environment {
+	env := object.NewEnclosedEnvironment(fn.Env)
+
+	for i, param := range fn.Parameters {
+		env.Set(param.Value, args[i])
+	}
+
+	return env
+}
+
+func unwrapReturnValue(obj object.Object) object.Object {
+	if returnValue, ok := obj.(*object.ReturnValue); ok {
+		return returnValue.Value
+	}
+	return obj
+}
+
+func nativeBooleanToObject(input bool) *object.Boolean {
+	if input {
+		return TRUE
+	}
+	return FALSE
+}
+
+func evalProgram(statements []ast.Statement, env *object.Environment) object.Object {
+	var result object.Object
+
+	for _, stmt := range statements {
+		result = Eval(stmt, env)
+
+		// * return early, if result is an object.ReturnValue or an object.Error
+		switch result := result.(type) {
+		case *object.ReturnValue:
+			return result.Value
+		case *object.Error:
+			return result
+		}
+	}
+
+	return result
+}
+
+func evalBlockStatement(statements []ast.Statement, env *object.Environment) object.Object {
+	var result object.Object
+
+	for _, stmt := range statements {
+		result = Eval(stmt, env)
+
+		if result != nil {
+			// * return early, if result type is object.O_RETURN_VALUE or object.O_ERROR
+			if result.Type() == object.O_RETURN_VALUE || isError(result) {
+				return result
+			}
+		}
+	}
+
+	return result
+}
+
+func evalPrefixExpression(operator string, operand object.Object) object.Object {
+	switch operator {
+	case "!":
+		return evalBangOperatorExpression(operand)
+	case "-":
+		return evalDashOperatorExpression(operand)
+	}
+	return newError(ERR_PREFIX_UNKNOWN, operator, operand.Type())
+}
+
+// evalBangOperatorExpression returns the opposite object of the isTruthy(operand) result
+func evalBangOperatorExpression(operand object.Object) object.Object {
+	if isTruthy(operand) {
+		return FALSE
+	}
+	return TRUE
+}
+
+func evalDashOperatorExpression(operand object.Object) object.Object {
+	if operand.Type() != object.O_INTEGER {
+		return newError(ERR_PREFIX_UNKNOWN, "-", operand.Type())
+	}
+
+	value := operand.(*object.Integer).Value
+	return &object.Integer{Value: -value}
+}
+
+func evalInfixExpression(operator string, left, right object.Object) object.Object {
+	switch {
+	// * need to switch on both the type of left and right
+	case left.Type() != right.Type():
+		return newError(ERR_INFIX_MISMATCH, left.Type(), operator, right.Type())
+	case left.Type() == object.O_INTEGER && right.Type() == object.O_INTEGER:
+		return evalIntegerInfixExpression(operator, left, right)
+	case left.Type() == object.O_STRING && right.Type() == object.O_STRING:
+		return evalStringInfixExpression(operator, left, right)
+
+	// * special cases for infix operators '==' and '!='
+	// * directly compare pointers, since booleans and null use global objects
+	// * integers and strings are handled by preceding cases; other objects
+	// * (arrays, hashes, functions, ...) compare by identity
+	case operator == "==":
+		return nativeBooleanToObject(left == right)
+	case operator == "!=":
+		return nativeBooleanToObject(left != right)
+	}
+
+	return newError(ERR_INFIX_UNKNOWN, left.Type(), operator, right.Type())
+}
+
+func evalIntegerInfixExpression(operator string, left, right object.Object) object.Object {
+	leftInt := left.(*object.Integer).Value
+	rightInt := right.(*object.Integer).Value
+	var newInt int64
+	switch operator {
+	case "+":
+		newInt = leftInt + rightInt
+	case "-":
+		newInt = leftInt - rightInt
+	case "*":
+		newInt = leftInt * rightInt
+	case "/":
+		newInt = leftInt / rightInt
+	case "==":
+		return nativeBooleanToObject(leftInt == rightInt)
+	case "!=":
+		return nativeBooleanToObject(leftInt != rightInt)
+	case "<":
+		return nativeBooleanToObject(leftInt < rightInt)
+	case ">":
+		return nativeBooleanToObject(leftInt > rightInt)
+	default:
+		return newError(ERR_INFIX_UNKNOWN, left.Type(), operator, right.Type())
+	}
+
+	return &object.Integer{Value: newInt}
+}
+
+// evalStringInfixExpression only supports "+", which concatenates left and right.
+func evalStringInfixExpression(operator string, left, right object.Object) object.Object {
+	if operator != "+" {
+		return newError(ERR_INFIX_UNKNOWN, left.Type(), operator, right.Type())
+	}
+
+	leftStr := left.(*object.String).Value
+	rightStr := right.(*object.String).Value
+	return &object.String{Value: leftStr + rightStr}
+}
+
+// evalIndexExpression dispatches on the type of left to evalArrayIndexExpression,
+// evalStringIndexExpression, or evalHashIndexExpression.
+func evalIndexExpression(left, index object.Object) object.Object {
+	switch {
+	case left.Type() == object.O_ARRAY && index.Type() == object.O_INTEGER:
+		return evalArrayIndexExpression(left, index)
+	case left.Type() == object.O_STRING && index.Type() == object.O_INTEGER:
+		return evalStringIndexExpression(left, index)
+	case left.Type() == object.O_HASH:
+		return evalHashIndexExpression(left, index)
+	default:
+		return newError(ERR_INDEX_UNSUPPORTED, left.Type())
+	}
+}
+
+func evalArrayIndexExpression(array, index object.Object) object.Object {
+	arrayObj := array.(*object.Array)
+	idx := index.(*object.Integer).Value
+	max := int64(len(arrayObj.Elements) - 1)
+
+	if idx < 0 || idx > max {
+		return NULL
+	}
+
+	return arrayObj.Elements[idx]
+}
+
+// evalStringIndexExpression returns the single-character object.String at idx,
+// or NULL if idx is out of range.
+func evalStringIndexExpression(str, index object.Object) object.Object {
+	strObj := str.(*object.String)
+	idx := index.(*object.Integer).Value
+	max := int64(len(strObj.Value) - 1)
+
+	if idx < 0 || idx > max {
+		return NULL
+	}
+
+	return &object.String{Value: string(strObj.Value[idx])}
+}
+
+func evalHashLiteral(node *ast.HashLiteral, env *object.Environment) object.Object {
+	pairs := make(map[object.HashKey]object.HashPair)
+
+	for keyNode, valueNode := range node.Pairs {
+		key := Eval(keyNode, env)
+		if isError(key) {
+			return key
+		}
+
+		hashable, ok := key.(object.Hashable)
+		if !ok {
+			return newError(ERR_UNUSABLE_HASH_KEY, key.Type())
+		}
+
+		value := Eval(valueNode, env)
+		if isError(value) {
+			return value
+		}
+
+		pairs[hashable.HashKey()] = object.HashPair{Key: key, Value: value}
+	}
+
+	return &object.Hash{Pairs: pairs}
+}
+
+func evalHashIndexExpression(hash, index object.Object) object.Object {
+	hashObj := hash.(*object.Hash)
+
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return newError(ERR_UNUSABLE_HASH_KEY, index.Type())
+	}
+
+	pair, ok := hashObj.Pairs[key.HashKey()]
+	if !ok {
+		return NULL
+	}
+
+	return pair.Value
+}
+
+func evalIfExpression(ie *ast.IfExpression, env *object.Environment) object.Object {
+	condition := Eval(ie.Condition, env)
+	if isError(condition) {
+		return condition
+	}
+
+	if isTruthy(condition) {
+		return Eval(ie.Then, env)
+	} else if ie.Otherwise != nil {
+		return Eval(ie.Otherwise, env)
+	}
+
+	return NULL
+}
+
+func evalIdentifier(node *ast.Identifier, env *object.Environment) object.Object {
+	if val, ok := env.Get(node.Value); ok {
+		return val
+	}
+
+	if builtin, ok := Builtins[node.Value]; ok {
+		return builtin
+	}
+
+	return newError(ERR_IDENTIFIER_UNKNOWN, node.Value)
+}
+
+/// Types
+
+type ErrorFormat string
@@ -0,0 +1,200 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/smalldevshima/go-monkey/lexer"
+	"github.com/smalldevshima/go-monkey/object"
+	"github.com/smalldevshima/go-monkey/parser"
+)
+
+func testEval(input string) object.Object {
+	l := lexer.New(input)
+	p := parser.New(l)
+	program := p.ParseProgram()
+	env := object.NewEnvironment()
+
+	return Eval(program, env)
+}
+
+func checkStringObject(t *testing.T, obj object.Object, expected string) {
+	t.Helper()
+
+	str, ok := obj.(*object.String)
+	if !ok {
+		t.Fatalf("obj is not *object.String. got=%T (%+v)", obj, obj)
+	}
+	if str.Value != expected {
+		t.Errorf("str.Value is not %q. got=%q", expected, str.Value)
+	}
+}
+
+func checkIntegerObject(t *testing.T, obj object.Object, expected int64) {
+	t.Helper()
+
+	intObj, ok := obj.(*object.Integer)
+	if !ok {
+		t.Fatalf("obj is not *object.Integer. got=%T (%+v)", obj, obj)
+	}
+	if intObj.Value != expected {
+		t.Errorf("intObj.Value is not %d. got=%d", expected, intObj.Value)
+	}
+}
+
+func TestStringConcatenation(t *testing.T) {
+	input := `"hello" + " " + "world"`
+
+	evaluated := testEval(input)
+	checkStringObject(t, evaluated, "hello world")
+}
+
+func TestArrayLiteral(t *testing.T) {
+	input := "[1, 2 * 2, 3 + 3]"
+
+	evaluated := testEval(input)
+	arr, ok := evaluated.(*object.Array)
+	if !ok {
+		t.Fatalf("evaluated is not *object.Array. got=%T (%+v)", evaluated, evaluated)
+	}
+	if len(arr.Elements) != 3 {
+		t.Fatalf("arr.Elements does not contain 3 elements. got=%d", len(arr.Elements))
+	}
+
+	checkIntegerObject(t, arr.Elements[0], 1)
+	checkIntegerObject(t, arr.Elements[1], 4)
+	checkIntegerObject(t, arr.Elements[2], 6)
+}
+
+func TestArrayIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"[1, 2, 3][0]", 1},
+		{"[1, 2, 3][1]", 2},
+		{"[1, 2, 3][2]", 3},
+		{"[1, 2, 3][1 + 1];", 3},
+		{"[1, 2, 3][3]", nil},
+		{"[1, 2, 3][-1]", nil},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		integer, ok := test.expected.(int)
+		if ok {
+			checkIntegerObject(t, evaluated, int64(integer))
+		} else if evaluated != NULL {
+			t.Errorf("object is not NULL. got=%T (%+v)", evaluated, evaluated)
+		}
+	}
+}
+
+func TestStringIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`"hello"[0]`, "h"},
+		{`"hello"[1]`, "e"},
+		{`"hello"[4]`, "o"},
+		{`"hello"[1 + 1]`, "l"},
+		{`"hello"[5]`, nil},
+		{`"hello"[-1]`, nil},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		str, ok := test.expected.(string)
+		if ok {
+			checkStringObject(t, evaluated, str)
+		} else if evaluated != NULL {
+			t.Errorf("object is not NULL. got=%T (%+v)", evaluated, evaluated)
+		}
+	}
+}
+
+func TestHashLiteral(t *testing.T) {
+	input := `{"one": 1, "two": 2, "three": 3, 4: 4, true: 5, false: 6}`
+
+	evaluated := testEval(input)
+	result, ok := evaluated.(*object.Hash)
+	if !ok {
+		t.Fatalf("evaluated is not *object.Hash. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := map[object.HashKey]int64{
+		(&object.String{Value: "one"}).HashKey():   1,
+		(&object.String{Value: "two"}).HashKey():   2,
+		(&object.String{Value: "three"}).HashKey(): 3,
+		(&object.Integer{Value: 4}).HashKey():      4,
+		TRUE.HashKey():                             5,
+		FALSE.HashKey():                            6,
+	}
+
+	if len(result.Pairs) != len(expected) {
+		t.Fatalf("result.Pairs does not have %d pairs. got=%d", len(expected), len(result.Pairs))
+	}
+
+	for expectedKey, expectedValue := range expected {
+		pair, ok := result.Pairs[expectedKey]
+		if !ok {
+			t.Errorf("no pair for given key in Pairs")
+			continue
+		}
+		checkIntegerObject(t, pair.Value, expectedValue)
+	}
+}
+
+func TestHashIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`{"foo": 5}["foo"]`, 5},
+		{`{"foo": 5}["bar"]`, nil},
+		{`{5: 5}[5]`, 5},
+		{`{true: 5}[true]`, 5},
+		{`{false: 5}[false]`, 5},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		integer, ok := test.expected.(int)
+		if ok {
+			checkIntegerObject(t, evaluated, int64(integer))
+		} else if evaluated != NULL {
+			t.Errorf("object is not NULL. got=%T (%+v)", evaluated, evaluated)
+		}
+	}
+}
+
+func TestBuiltinLen(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`len("")`, 0},
+		{`len("four")`, 4},
+		{`len("hello world")`, 11},
+		{`len(1)`, "argument to \"len\" not supported, got INTEGER"},
+		{`len("one", "two")`, "wrong number of arguments. got=2, want=1"},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+
+		switch expected := test.expected.(type) {
+		case int:
+			checkIntegerObject(t, evaluated, int64(expected))
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+			if !ok {
+				t.Errorf("object is not *object.Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+			if errObj.Message != expected {
+				t.Errorf("errObj.Message is not %q. got=%q", expected, errObj.Message)
+			}
+		}
+	}
+}
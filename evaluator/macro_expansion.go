@@ -0,0 +1,106 @@
+package evaluator
+
+import (
+	"github.com/smalldevshima/go-monkey/ast"
+	"github.com/smalldevshima/go-monkey/object"
+)
+
+// DefineMacros extracts every top-level `let x = macro(...) {...}` statement
+// from program into an object.Macro bound to x in env, removing those
+// statements from the program.
+func DefineMacros(program *ast.Program, env *object.Environment) {
+	definitions := []int{}
+
+	for i, stmt := range program.Statements {
+		if isMacroDefinition(stmt) {
+			addMacro(stmt, env)
+			definitions = append(definitions, i)
+		}
+	}
+
+	for i := len(definitions) - 1; i >= 0; i-- {
+		definitionIndex := definitions[i]
+		program.Statements = append(
+			program.Statements[:definitionIndex],
+			program.Statements[definitionIndex+1:]...,
+		)
+	}
+}
+
+func isMacroDefinition(node ast.Statement) bool {
+	letStmt, ok := node.(*ast.LetStatement)
+	if !ok {
+		return false
+	}
+	_, ok = letStmt.Value.(*ast.MacroLiteral)
+	return ok
+}
+
+func addMacro(stmt ast.Statement, env *object.Environment) {
+	letStmt, _ := stmt.(*ast.LetStatement)
+	macroLit, _ := letStmt.Value.(*ast.MacroLiteral)
+
+	macro := &object.Macro{Parameters: macroLit.Parameters, Body: macroLit.Body, Env: env}
+	env.Set(letStmt.Name.Value, macro)
+}
+
+// ExpandMacros walks program, replacing every call to a previously-defined
+// macro with the result of expanding its body against quoted arguments.
+func ExpandMacros(program ast.Node, env *object.Environment) ast.Node {
+	return ast.Modify(program, func(node ast.Node) ast.Node {
+		call, ok := node.(*ast.CallExpression)
+		if !ok {
+			return node
+		}
+
+		macro, ok := isMacroCall(call, env)
+		if !ok {
+			return node
+		}
+
+		args := quoteArgs(call)
+		evalEnv := extendMacroEnv(macro, args)
+
+		evaluated := Eval(macro.Body, evalEnv)
+
+		quote, ok := evaluated.(*object.Quote)
+		if !ok {
+			panic("we only support returning AST-nodes from macros")
+		}
+
+		return quote.Node
+	})
+}
+
+func isMacroCall(call *ast.CallExpression, env *object.Environment) (*object.Macro, bool) {
+	ident, ok := call.Function.(*ast.Identifier)
+	if !ok {
+		return nil, false
+	}
+
+	obj, ok := env.Get(ident.Value)
+	if !ok {
+		return nil, false
+	}
+
+	macro, ok := obj.(*object.Macro)
+	return macro, ok
+}
+
+func quoteArgs(call *ast.CallExpression) []*object.Quote {
+	args := make([]*object.Quote, 0, len(call.Arguments))
+	for _, a := range call.Arguments {
+		args = append(args, &object.Quote{Node: a})
+	}
+	return args
+}
+
+func extendMacroEnv(macro *object.Macro, args []*object.Quote) *object.Environment {
+	extended := object.NewEnclosedEnvironment(macro.Env)
+
+	for i, param := range macro.Parameters {
+		extended.Set(param.Value, args[i])
+	}
+
+	return extended
+}
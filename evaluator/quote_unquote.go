@@ -0,0 +1,65 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/smalldevshima/go-monkey/ast"
+	"github.com/smalldevshima/go-monkey/object"
+	"github.com/smalldevshima/go-monkey/token"
+)
+
+// quote returns node wrapped in an object.Quote, after resolving any
+// unquote(...) calls nested inside it.
+func quote(node ast.Node, env *object.Environment) object.Object {
+	node = evalUnquoteCalls(node, env)
+	return &object.Quote{Node: node}
+}
+
+// evalUnquoteCalls walks node and replaces every unquote(x) call with the AST
+// representation of evaluating x in env.
+func evalUnquoteCalls(quoted ast.Node, env *object.Environment) ast.Node {
+	return ast.Modify(quoted, func(node ast.Node) ast.Node {
+		if !isUnquoteCall(node) {
+			return node
+		}
+
+		call, ok := node.(*ast.CallExpression)
+		if !ok || len(call.Arguments) != 1 {
+			return node
+		}
+
+		unquoted := Eval(call.Arguments[0], env)
+		return convertObjectToASTNode(unquoted)
+	})
+}
+
+func isUnquoteCall(node ast.Node) bool {
+	call, ok := node.(*ast.CallExpression)
+	if !ok {
+		return false
+	}
+	ident, ok := call.Function.(*ast.Identifier)
+	return ok && ident.Value == "unquote"
+}
+
+// convertObjectToASTNode turns the result of evaluating an unquote(...)
+// argument back into an AST node that can be spliced into the quoted tree.
+func convertObjectToASTNode(obj object.Object) ast.Node {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		tok := token.Token{Type: token.INTEGER, Literal: fmt.Sprintf("%d", obj.Value)}
+		return &ast.IntegerLiteral{Token: tok, Value: obj.Value}
+	case *object.Boolean:
+		var tok token.Token
+		if obj.Value {
+			tok = token.Token{Type: token.TRUE, Literal: "true"}
+		} else {
+			tok = token.Token{Type: token.FALSE, Literal: "false"}
+		}
+		return &ast.BooleanLiteral{Token: tok, Value: obj.Value}
+	case *object.Quote:
+		return obj.Node
+	default:
+		return nil
+	}
+}
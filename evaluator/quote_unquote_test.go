@@ -0,0 +1,80 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/smalldevshima/go-monkey/object"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`quote(5)`, `5`},
+		{`quote(5 + 8)`, `(5 + 8)`},
+		{`quote(foobar)`, `foobar`},
+		{`quote(foobar + barfoo)`, `(foobar + barfoo)`},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		quote, ok := evaluated.(*object.Quote)
+		if !ok {
+			t.Fatalf("expected *object.Quote. got=%T (%+v)", evaluated, evaluated)
+		}
+		if quote.Node == nil {
+			t.Fatalf("quote.Node is nil")
+		}
+		if quote.Node.String() != test.expected {
+			t.Errorf("node.String() is not %q. got=%q", test.expected, quote.Node.String())
+		}
+	}
+}
+
+func TestQuoteWrongArgCount(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`quote()`, "wrong number of arguments to quote: got=0, want=1"},
+		{`quote(5, 10)`, "wrong number of arguments to quote: got=2, want=1"},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Fatalf("expected *object.Error. got=%T (%+v)", evaluated, evaluated)
+		}
+		if errObj.Message != test.expected {
+			t.Errorf("errObj.Message is not %q. got=%q", test.expected, errObj.Message)
+		}
+	}
+}
+
+func TestQuoteUnquote(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`quote(unquote(4))`, `4`},
+		{`quote(unquote(4 + 4))`, `8`},
+		{`quote(8 + unquote(4 + 4))`, `(8 + 8)`},
+		{`quote(unquote(4 + 4) + 8)`, `(8 + 8)`},
+	}
+
+	for _, test := range tests {
+		evaluated := testEval(test.input)
+		quote, ok := evaluated.(*object.Quote)
+		if !ok {
+			t.Fatalf("expected *object.Quote. got=%T (%+v)", evaluated, evaluated)
+		}
+		if quote.Node == nil {
+			t.Fatalf("quote.Node is nil")
+		}
+		if quote.Node.String() != test.expected {
+			t.Errorf("node.String() is not %q. got=%q", test.expected, quote.Node.String())
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package lexer
+
+import (
+	"github.com/smalldevshima/go-monkey/token"
+)
+
+/// Types
+
+// Lexer turns Monkey source code into a stream of token.Token values.
+type Lexer struct {
+	input string
+
+	position     int // current position in input (points to current char)
+	readPosition int // current reading position in input (after current char)
+	char         byte
+}
+
+/// Functions
+
+// New creates a Lexer ready to tokenize input.
+func New(input string) *Lexer {
+	l := &Lexer{input: input}
+	l.readChar()
+	return l
+}
+
+// readChar advances the Lexer by one character.
+func (l *Lexer) readChar() {
+	if l.readPosition >= len(l.input) {
+		l.char = 0
+	} else {
+		l.char = l.input[l.readPosition]
+	}
+	l.position = l.readPosition
+	l.readPosition++
+}
+
+// peekChar returns the next character without advancing the Lexer.
+func (l *Lexer) peekChar() byte {
+	if l.readPosition >= len(l.input) {
+		return 0
+	}
+	return l.input[l.readPosition]
+}
+
+// NextToken consumes and returns the next token.Token from the input.
+func (l *Lexer) NextToken() token.Token {
+	var tok token.Token
+
+	l.skipWhitespace()
+
+	switch l.char {
+	case '=':
+		if l.peekChar() == '=' {
+			char := l.char
+			l.readChar()
+			tok = token.Token{Type: token.EQ, Literal: string(char) + string(l.char)}
+		} else {
+			tok = newToken(token.ASSIGN, l.char)
+		}
+	case '+':
+		tok = newToken(token.PLUS, l.char)
+	case '-':
+		tok = newToken(token.MINUS, l.char)
+	case '!':
+		if l.peekChar() == '=' {
+			char := l.char
+			l.readChar()
+			tok = token.Token{Type: token.NOT_EQ, Literal: string(char) + string(l.char)}
+		} else {
+			tok = newToken(token.BANG, l.char)
+		}
+	case '*':
+		tok = newToken(token.ASTERISK, l.char)
+	case '/':
+		tok = newToken(token.SLASH, l.char)
+	case '<':
+		tok = newToken(token.LT, l.char)
+	case '>':
+		tok = newToken(token.GT, l.char)
+	case ';':
+		tok = newToken(token.SEMICOLON, l.char)
+	case ':':
+		tok = newToken(token.COLON, l.char)
+	case ',':
+		tok = newToken(token.COMMA, l.char)
+	case '(':
+		tok = newToken(token.LPAREN, l.char)
+	case ')':
+		tok = newToken(token.RPAREN, l.char)
+	case '{':
+		tok = newToken(token.LBRACE, l.char)
+	case '}':
+		tok = newToken(token.RBRACE, l.char)
+	case '[':
+		tok = newToken(token.LBRACKET, l.char)
+	case ']':
+		tok = newToken(token.RBRACKET, l.char)
+	case '"':
+		tok.Type = token.STRING
+		tok.Literal = l.readString()
+	case 0:
+		tok.Literal = ""
+		tok.Type = token.EOF
+	default:
+		if isLetter(l.char) {
+			tok.Literal = l.readIdentifier()
+			tok.Type = token.LookupIdentifier(tok.Literal)
+			return tok
+		} else if isDigit(l.char) {
+			tok.Type = token.INTEGER
+			tok.Literal = l.readNumber()
+			return tok
+		} else {
+			tok = newToken(token.ILLEGAL, l.char)
+		}
+	}
+
+	l.readChar()
+	return tok
+}
+
+// skipWhitespace advances the Lexer past any run of whitespace characters.
+func (l *Lexer) skipWhitespace() {
+	for l.char == ' ' || l.char == '\t' || l.char == '\n' || l.char == '\r' {
+		l.readChar()
+	}
+}
+
+// readIdentifier consumes and returns a run of letters.
+func (l *Lexer) readIdentifier() string {
+	start := l.position
+	for isLetter(l.char) {
+		l.readChar()
+	}
+	return l.input[start:l.position]
+}
+
+// readNumber consumes and returns a run of digits.
+func (l *Lexer) readNumber() string {
+	start := l.position
+	for isDigit(l.char) {
+		l.readChar()
+	}
+	return l.input[start:l.position]
+}
+
+// readString consumes and returns the contents of a double-quoted string,
+// leaving the Lexer positioned on the closing quote.
+func (l *Lexer) readString() string {
+	start := l.position + 1
+	for {
+		l.readChar()
+		if l.char == '"' || l.char == 0 {
+			break
+		}
+	}
+	return l.input[start:l.position]
+}
+
+func newToken(tokType token.TokenType, char byte) token.Token {
+	return token.Token{Type: tokType, Literal: string(char)}
+}
+
+func isLetter(char byte) bool {
+	return 'a' <= char && char <= 'z' || 'A' <= char && char <= 'Z' || char == '_'
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
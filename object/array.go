@@ -0,0 +1,27 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Array is an ordered, heterogeneous sequence of Objects.
+type Array struct {
+	Elements []Object
+}
+
+func (a *Array) Type() ObjectType { return O_ARRAY }
+func (a *Array) Inspect() string {
+	var out bytes.Buffer
+
+	elements := make([]string, 0, len(a.Elements))
+	for _, el := range a.Elements {
+		elements = append(elements, el.Inspect())
+	}
+
+	out.WriteString("[")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("]")
+
+	return out.String()
+}
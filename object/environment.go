@@ -0,0 +1,41 @@
+package object
+
+/// Types
+
+// Environment maps identifiers to the Object bound to them, optionally
+// falling back to an enclosing (outer) Environment for closures.
+type Environment struct {
+	store map[string]Object
+	outer *Environment
+}
+
+/// Functions
+
+// NewEnvironment creates an empty, unenclosed Environment.
+func NewEnvironment() *Environment {
+	return &Environment{store: make(map[string]Object)}
+}
+
+// NewEnclosedEnvironment creates an Environment that falls back to outer for
+// identifiers it does not itself define.
+func NewEnclosedEnvironment(outer *Environment) *Environment {
+	env := NewEnvironment()
+	env.outer = outer
+	return env
+}
+
+// Get looks up name, checking the enclosing Environment if it is not found
+// in the current one.
+func (e *Environment) Get(name string) (Object, bool) {
+	val, ok := e.store[name]
+	if !ok && e.outer != nil {
+		val, ok = e.outer.Get(name)
+	}
+	return val, ok
+}
+
+// Set binds name to val in the current Environment.
+func (e *Environment) Set(name string, val Object) Object {
+	e.store[name] = val
+	return val
+}
@@ -0,0 +1,33 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/smalldevshima/go-monkey/ast"
+)
+
+// Macro is a compile-time macro defined via `let x = macro(...) {...}`.
+type Macro struct {
+	Parameters []*ast.Identifier
+	Body       *ast.BlockStatement
+	Env        *Environment
+}
+
+func (m *Macro) Type() ObjectType { return O_MACRO }
+func (m *Macro) Inspect() string {
+	var out bytes.Buffer
+
+	params := make([]string, 0, len(m.Parameters))
+	for _, p := range m.Parameters {
+		params = append(params, p.String())
+	}
+
+	out.WriteString("macro(")
+	out.WriteString(strings.Join(params, ", "))
+	out.WriteString(") {\n")
+	out.WriteString(m.Body.String())
+	out.WriteString("\n}")
+
+	return out.String()
+}
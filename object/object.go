@@ -0,0 +1,123 @@
+package object
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/smalldevshima/go-monkey/ast"
+)
+
+/// Types
+
+// ObjectType identifies the runtime type of an Object.
+type ObjectType string
+
+// Object is implemented by every value the evaluator can produce.
+type Object interface {
+	Type() ObjectType
+	Inspect() string
+}
+
+// BuiltinFunction is the Go implementation backing a Builtin.
+type BuiltinFunction func(args ...Object) Object
+
+/// Constants / Variables
+
+const (
+	O_INTEGER      ObjectType = "INTEGER"
+	O_BOOLEAN      ObjectType = "BOOLEAN"
+	O_STRING       ObjectType = "STRING"
+	O_NULL         ObjectType = "NULL"
+	O_RETURN_VALUE ObjectType = "RETURN_VALUE"
+	O_ERROR        ObjectType = "ERROR"
+	O_FUNCTION     ObjectType = "FUNCTION"
+	O_BUILTIN      ObjectType = "BUILTIN"
+	O_ARRAY        ObjectType = "ARRAY"
+	O_HASH         ObjectType = "HASH"
+	O_QUOTE        ObjectType = "QUOTE"
+	O_MACRO        ObjectType = "MACRO"
+)
+
+/// Object types
+
+// Integer wraps an int64 value.
+type Integer struct {
+	Value int64
+}
+
+func (i *Integer) Type() ObjectType { return O_INTEGER }
+func (i *Integer) Inspect() string  { return fmt.Sprintf("%d", i.Value) }
+
+// Boolean wraps a bool value.
+type Boolean struct {
+	Value bool
+}
+
+func (b *Boolean) Type() ObjectType { return O_BOOLEAN }
+func (b *Boolean) Inspect() string  { return fmt.Sprintf("%t", b.Value) }
+
+// String wraps a string value.
+type String struct {
+	Value string
+}
+
+func (s *String) Type() ObjectType { return O_STRING }
+func (s *String) Inspect() string  { return s.Value }
+
+// Null is the sole value representing the absence of a value.
+type Null struct{}
+
+func (n *Null) Type() ObjectType { return O_NULL }
+func (n *Null) Inspect() string  { return "null" }
+
+// ReturnValue wraps the Object produced by a return statement so it can be
+// propagated up through nested statements without further evaluation.
+type ReturnValue struct {
+	Value Object
+}
+
+func (rv *ReturnValue) Type() ObjectType { return O_RETURN_VALUE }
+func (rv *ReturnValue) Inspect() string  { return rv.Value.Inspect() }
+
+// Error wraps an evaluation error message.
+type Error struct {
+	Message string
+}
+
+func (e *Error) Type() ObjectType { return O_ERROR }
+func (e *Error) Inspect() string  { return "ERROR: " + e.Message }
+
+// Function is a user-defined function value, closing over the Environment
+// it was defined in.
+type Function struct {
+	Parameters []*ast.Identifier
+	Body       *ast.BlockStatement
+	Env        *Environment
+}
+
+func (f *Function) Type() ObjectType { return O_FUNCTION }
+func (f *Function) Inspect() string {
+	var out bytes.Buffer
+
+	params := make([]string, 0, len(f.Parameters))
+	for _, p := range f.Parameters {
+		params = append(params, p.String())
+	}
+
+	out.WriteString("fn(")
+	out.WriteString(strings.Join(params, ", "))
+	out.WriteString(") {\n")
+	out.WriteString(f.Body.String())
+	out.WriteString("\n}")
+
+	return out.String()
+}
+
+// Builtin wraps a built-in function implemented in Go.
+type Builtin struct {
+	Fn BuiltinFunction
+}
+
+func (b *Builtin) Type() ObjectType { return O_BUILTIN }
+func (b *Builtin) Inspect() string  { return "builtin function" }
@@ -0,0 +1,13 @@
+package object
+
+import (
+	"github.com/smalldevshima/go-monkey/ast"
+)
+
+// Quote wraps an unevaluated AST node, produced by the `quote` macro.
+type Quote struct {
+	Node ast.Node
+}
+
+func (q *Quote) Type() ObjectType { return O_QUOTE }
+func (q *Quote) Inspect() string  { return "QUOTE(" + q.Node.String() + ")" }
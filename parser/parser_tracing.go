@@ -0,0 +1,43 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TraceOn gates the output of the tracing calls below. It defaults to enabled
+// only when MONKEY_PARSER_TRACE is set; when it is off, trace and untrace
+// still adjust the indentation counter but print nothing.
+var TraceOn = os.Getenv("MONKEY_PARSER_TRACE") != ""
+
+var traceIndentLevel int
+
+const traceIndentPlaceholder = "\t"
+
+func traceIndent() string {
+	return strings.Repeat(traceIndentPlaceholder, traceIndentLevel-1)
+}
+
+func (p *Parser) tracePrint(fs string) {
+	if TraceOn {
+		fmt.Printf("%s%s (current=%q, peek=%q)\n", traceIndent(), fs, p.currentToken.Literal, p.peekToken.Literal)
+	}
+}
+
+func incIndent() { traceIndentLevel = traceIndentLevel + 1 }
+func decIndent() { traceIndentLevel = traceIndentLevel - 1 }
+
+// trace logs entry into msg and returns it for a matching untrace call.
+// Use as: defer p.untrace(p.trace("parseX")).
+func (p *Parser) trace(msg string) string {
+	incIndent()
+	p.tracePrint("BEGIN " + msg)
+	return msg
+}
+
+// untrace logs exit from the msg returned by trace.
+func (p *Parser) untrace(msg string) {
+	p.tracePrint("END " + msg)
+	decIndent()
+}
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/smalldevshima/go-monkey/lexer"
+)
+
+func TestTrace(t *testing.T) {
+	TraceOn = true
+	defer func() { TraceOn = false }()
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("could not create pipe: %s", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = oldStdout }()
+
+	l := lexer.New("return 5 + 5;")
+	New(l).ParseProgram()
+
+	w.Close()
+	captured, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("could not read captured output: %s", err)
+	}
+	output := string(captured)
+
+	for _, want := range []string{
+		"BEGIN parseStatement",
+		"BEGIN parseReturnStatement",
+		"BEGIN parseExpression",
+		"BEGIN parseInfixExpression",
+		"END parseInfixExpression",
+		"END parseExpression",
+		"END parseReturnStatement",
+		"END parseStatement",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q.\noutput=%s", want, output)
+		}
+	}
+
+	// parseInfixExpression must be traced after (nested inside) parseReturnStatement.
+	returnIndex := strings.Index(output, "BEGIN parseReturnStatement")
+	infixIndex := strings.Index(output, "BEGIN parseInfixExpression")
+	if infixIndex < returnIndex {
+		t.Errorf("parseInfixExpression traced before parseReturnStatement")
+	}
+}
@@ -0,0 +1,52 @@
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/smalldevshima/go-monkey/evaluator"
+	"github.com/smalldevshima/go-monkey/lexer"
+	"github.com/smalldevshima/go-monkey/object"
+	"github.com/smalldevshima/go-monkey/parser"
+)
+
+const PROMPT = ">> "
+
+// Start runs a read-eval-print loop over in, writing results and errors to out.
+func Start(in io.Reader, out io.Writer) {
+	scanner := bufio.NewScanner(in)
+	env := object.NewEnvironment()
+	macroEnv := object.NewEnvironment()
+
+	for {
+		fmt.Fprint(out, PROMPT)
+		if !scanner.Scan() {
+			return
+		}
+
+		line := scanner.Text()
+		l := lexer.New(line)
+		p := parser.New(l)
+
+		program := p.ParseProgram()
+		if errors := p.Errors(); len(errors) != 0 {
+			printParserErrors(out, errors)
+			continue
+		}
+
+		evaluator.DefineMacros(program, macroEnv)
+		expanded := evaluator.ExpandMacros(program, macroEnv)
+
+		evaluated := evaluator.Eval(expanded, env)
+		if evaluated != nil {
+			fmt.Fprintln(out, evaluated.Inspect())
+		}
+	}
+}
+
+func printParserErrors(out io.Writer, errors []string) {
+	for _, msg := range errors {
+		fmt.Fprintln(out, "\t"+msg)
+	}
+}
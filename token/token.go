@@ -0,0 +1,83 @@
+package token
+
+/// Types
+
+// TokenType identifies the lexical category of a Token.
+type TokenType string
+
+// Token is a single lexical unit produced by the lexer.
+type Token struct {
+	Type    TokenType
+	Literal string
+}
+
+/// Constants / Variables
+
+const (
+	ILLEGAL TokenType = "ILLEGAL"
+	EOF     TokenType = "EOF"
+
+	// Identifiers + literals
+	IDENTIFIER TokenType = "IDENTIFIER"
+	INTEGER    TokenType = "INTEGER"
+	STRING     TokenType = "STRING"
+
+	// Operators
+	ASSIGN   TokenType = "="
+	PLUS     TokenType = "+"
+	MINUS    TokenType = "-"
+	BANG     TokenType = "!"
+	ASTERISK TokenType = "*"
+	SLASH    TokenType = "/"
+
+	LT TokenType = "<"
+	GT TokenType = ">"
+
+	EQ     TokenType = "=="
+	NOT_EQ TokenType = "!="
+
+	// Delimiters
+	COMMA     TokenType = ","
+	SEMICOLON TokenType = ";"
+	COLON     TokenType = ":"
+
+	LPAREN   TokenType = "("
+	RPAREN   TokenType = ")"
+	LBRACE   TokenType = "{"
+	RBRACE   TokenType = "}"
+	LBRACKET TokenType = "["
+	RBRACKET TokenType = "]"
+
+	// Keywords
+	FUNCTION TokenType = "FUNCTION"
+	LET      TokenType = "LET"
+	TRUE     TokenType = "TRUE"
+	FALSE    TokenType = "FALSE"
+	IF       TokenType = "IF"
+	ELSE     TokenType = "ELSE"
+	RETURN   TokenType = "RETURN"
+	MACRO    TokenType = "MACRO"
+)
+
+// keywords maps the language's reserved words to their TokenType.
+var keywords = map[string]TokenType{
+	"fn":     FUNCTION,
+	"let":    LET,
+	"true":   TRUE,
+	"false":  FALSE,
+	"if":     IF,
+	"else":   ELSE,
+	"return": RETURN,
+	"macro":  MACRO,
+}
+
+/// Functions
+
+// LookupIdentifier returns the keyword TokenType for ident, or IDENTIFIER if
+// ident is not a reserved word.
+func LookupIdentifier(ident string) TokenType {
+	if tokType, ok := keywords[ident]; ok {
+		return tokType
+	}
+	return IDENTIFIER
+}